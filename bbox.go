@@ -0,0 +1,127 @@
+package geo
+
+import "math"
+
+// BoundingBox returns the topLeft (northwest) and bottomRight (southeast)
+// corners of the box that circumscribes a circle of the given radius (in
+// kilometers) centered on the Point. It is meant as a cheap pre-filter for
+// candidate points before running the more expensive GreatCircleDistance or
+// Polygon.Contains.
+//
+// Latitude is always clamped to [-90, 90]. If the circle swallows a pole,
+// every meridian passes through it, so the box is widened to the full
+// [-180, 180] longitude range. If the circle crosses the antimeridian
+// without swallowing a pole, the box wraps: topLeft.Lon will be greater
+// than bottomRight.Lon, and the box should be read as covering
+// [topLeft.Lon, 180] U [-180, bottomRight.Lon]. Use BoxContains to test a
+// Point against a box returned by this method without handling the
+// wraparound by hand.
+func (p *Point) BoundingBox(radiusKm float64) (topLeft, bottomRight *Point) {
+	radDist := radiusKm / EarthRadius
+	radLat := p.Lat * math.Pi / 180.0
+	radLon := p.Lon * math.Pi / 180.0
+
+	minLat := radLat - radDist
+	maxLat := radLat + radDist
+
+	var minLon, maxLon float64
+
+	if minLat > -math.Pi/2 && maxLat < math.Pi/2 {
+		deltaLon := math.Asin(math.Sin(radDist) / math.Cos(radLat))
+
+		minLon = radLon - deltaLon
+		if minLon < -math.Pi {
+			minLon += 2 * math.Pi
+		}
+
+		maxLon = radLon + deltaLon
+		if maxLon > math.Pi {
+			maxLon -= 2 * math.Pi
+		}
+	} else {
+		// The circle swallows a pole, so every meridian passes through it.
+		minLat = math.Max(minLat, -math.Pi/2)
+		maxLat = math.Min(maxLat, math.Pi/2)
+		minLon = -math.Pi
+		maxLon = math.Pi
+	}
+
+	const toDeg = 180.0 / math.Pi
+	topLeft = &Point{Lat: maxLat * toDeg, Lon: minLon * toDeg}
+	bottomRight = &Point{Lat: minLat * toDeg, Lon: maxLon * toDeg}
+
+	return topLeft, bottomRight
+}
+
+// BoundingBox returns the topLeft (northwest) and bottomRight (southeast)
+// corners of the smallest axis-aligned box that contains the Polygon's
+// outer ring. Returns nil, nil for an empty Polygon.
+//
+// If the ring crosses the antimeridian, a plain min/max over its longitudes
+// would produce a box spanning nearly the entire globe instead of the thin
+// sliver the ring actually occupies. To detect that case, the longitudes are
+// also recomputed shifted into [0, 360): a ring that crosses the
+// antimeridian has a narrower span in that shifted range than in the
+// original one. When it does, the box is returned wrapped (topLeft.Lon >
+// bottomRight.Lon), in the same form produced by Point.BoundingBox and
+// understood by BoxContains.
+func (p *Polygon) BoundingBox() (topLeft, bottomRight *Point) {
+	if len(p.Rings) == 0 || len(p.Rings[0]) == 0 {
+		return nil, nil
+	}
+
+	ring := p.Rings[0]
+	minLat, maxLat := ring[0].Lat, ring[0].Lat
+	minLon, maxLon := ring[0].Lon, ring[0].Lon
+	minShiftedLon, maxShiftedLon := shiftLon(ring[0].Lon), shiftLon(ring[0].Lon)
+
+	for _, pt := range ring[1:] {
+		minLat = math.Min(minLat, pt.Lat)
+		maxLat = math.Max(maxLat, pt.Lat)
+		minLon = math.Min(minLon, pt.Lon)
+		maxLon = math.Max(maxLon, pt.Lon)
+
+		shifted := shiftLon(pt.Lon)
+		minShiftedLon = math.Min(minShiftedLon, shifted)
+		maxShiftedLon = math.Max(maxShiftedLon, shifted)
+	}
+
+	if maxShiftedLon-minShiftedLon < maxLon-minLon {
+		minLon, maxLon = minShiftedLon, maxShiftedLon
+		if minLon > 180 {
+			minLon -= 360
+		}
+		if maxLon > 180 {
+			maxLon -= 360
+		}
+		return &Point{Lat: maxLat, Lon: minLon}, &Point{Lat: minLat, Lon: maxLon}
+	}
+
+	return &Point{Lat: maxLat, Lon: minLon}, &Point{Lat: minLat, Lon: maxLon}
+}
+
+// shiftLon maps a longitude from [-180, 180] into [0, 360), so that a set of
+// longitudes straddling the antimeridian (e.g. 179 and -179) becomes
+// contiguous (179 and 181) instead of spanning almost the full range.
+func shiftLon(lon float64) float64 {
+	if lon < 0 {
+		return lon + 360
+	}
+	return lon
+}
+
+// BoxContains returns whether p falls within the box defined by topLeft and
+// bottomRight, as returned by Point.BoundingBox or Polygon.BoundingBox. It
+// correctly handles boxes that wrap across the antimeridian, i.e. where
+// topLeft.Lon > bottomRight.Lon.
+func BoxContains(topLeft, bottomRight, p *Point) bool {
+	if p.Lat > topLeft.Lat || p.Lat < bottomRight.Lat {
+		return false
+	}
+
+	if topLeft.Lon <= bottomRight.Lon {
+		return p.Lon >= topLeft.Lon && p.Lon <= bottomRight.Lon
+	}
+
+	return p.Lon >= topLeft.Lon || p.Lon <= bottomRight.Lon
+}
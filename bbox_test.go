@@ -0,0 +1,113 @@
+package geo
+
+import "testing"
+
+// Ensures that an ordinary bounding box (no pole or antimeridian crossing)
+// contains the center point and excludes a point well outside the radius.
+func TestPointBoundingBox(t *testing.T) {
+	p := &Point{Lat: 40.7486, Lon: -73.9864}
+	tl, br := p.BoundingBox(50)
+
+	if tl.Lon > br.Lon {
+		t.Fatal("Did not expect the box to wrap across the antimeridian")
+	}
+
+	if !BoxContains(tl, br, p) {
+		t.Error("Expected the bounding box to contain its own center point")
+	}
+
+	far := &Point{Lat: 40.7486, Lon: 0}
+	if BoxContains(tl, br, far) {
+		t.Error("Expected the bounding box to exclude a point far outside its radius")
+	}
+}
+
+// Ensures that a circle around the north pole clamps latitude to 90 and
+// expands longitude to the full range.
+func TestPointBoundingBoxNearPole(t *testing.T) {
+	p := &Point{Lat: 89.9, Lon: 10}
+	tl, br := p.BoundingBox(500)
+
+	if tl.Lat != 90 {
+		t.Errorf("Expected latitude to clamp to 90 near the pole, but got %f", tl.Lat)
+	}
+
+	if tl.Lon != -180 || br.Lon != 180 {
+		t.Errorf("Expected longitude to expand to the full range near the pole, but got [%f, %f]", tl.Lon, br.Lon)
+	}
+
+	if !BoxContains(tl, br, &Point{Lat: 89.9, Lon: -170}) {
+		t.Error("Expected the pole-spanning box to contain a point at any longitude")
+	}
+}
+
+// Ensures that a circle crossing the antimeridian produces a wrapped box
+// (topLeft.Lon > bottomRight.Lon) that BoxContains handles correctly.
+func TestPointBoundingBoxAntimeridian(t *testing.T) {
+	p := &Point{Lat: 0, Lon: 179.9}
+	tl, br := p.BoundingBox(50)
+
+	if tl.Lon <= br.Lon {
+		t.Fatalf("Expected a wrapped box (topLeft.Lon > bottomRight.Lon), got [%f, %f]", tl.Lon, br.Lon)
+	}
+
+	if !BoxContains(tl, br, p) {
+		t.Error("Expected the wrapped box to contain its own center point")
+	}
+
+	if !BoxContains(tl, br, &Point{Lat: 0, Lon: -179.9}) {
+		t.Error("Expected the wrapped box to contain a point just past the antimeridian")
+	}
+
+	if BoxContains(tl, br, &Point{Lat: 0, Lon: 0}) {
+		t.Error("Expected the wrapped box to exclude a point nowhere near the antimeridian")
+	}
+}
+
+// Ensures that Polygon.BoundingBox returns the min/max extent of the outer ring.
+func TestPolygonBoundingBox(t *testing.T) {
+	poly := NewPolygon(square(10))
+	tl, br := poly.BoundingBox()
+
+	if tl.Lat != 10 || tl.Lon != 0 {
+		t.Errorf("Expected topLeft of (10, 0), but got (%f, %f)", tl.Lat, tl.Lon)
+	}
+
+	if br.Lat != 0 || br.Lon != 10 {
+		t.Errorf("Expected bottomRight of (0, 10), but got (%f, %f)", br.Lat, br.Lon)
+	}
+}
+
+// Ensures that a Polygon whose outer ring crosses the antimeridian produces
+// a wrapped box (topLeft.Lon > bottomRight.Lon) rather than a box spanning
+// nearly the whole globe.
+func TestPolygonBoundingBoxAntimeridian(t *testing.T) {
+	ring := []Point{
+		{Lat: 10, Lon: 179},
+		{Lat: 10, Lon: -179},
+		{Lat: -10, Lon: -179},
+		{Lat: -10, Lon: 179},
+	}
+	poly := NewPolygon(ring)
+	tl, br := poly.BoundingBox()
+
+	if tl.Lon <= br.Lon {
+		t.Fatalf("Expected a wrapped box (topLeft.Lon > bottomRight.Lon), got [%f, %f]", tl.Lon, br.Lon)
+	}
+
+	if tl.Lon != 179 || br.Lon != -179 {
+		t.Errorf("Expected wrapped longitudes of (179, -179), but got (%f, %f)", tl.Lon, br.Lon)
+	}
+
+	if !BoxContains(tl, br, &Point{Lat: 0, Lon: 179.5}) {
+		t.Error("Expected the wrapped box to contain a point on the eastern side of the ring")
+	}
+
+	if !BoxContains(tl, br, &Point{Lat: 0, Lon: -179.5}) {
+		t.Error("Expected the wrapped box to contain a point on the western side of the ring")
+	}
+
+	if BoxContains(tl, br, &Point{Lat: 0, Lon: 0}) {
+		t.Error("Expected the wrapped box to exclude a point nowhere near the antimeridian")
+	}
+}
@@ -0,0 +1,120 @@
+package geo
+
+import (
+	"math"
+	"strings"
+)
+
+const geohashBase32 = "0123456789bcdefghjkmnpqrstuvwxyz"
+
+// GeoHash encodes the Point as a base-32 geohash string of the given
+// precision (number of characters). The longitude range [-180,180] and
+// latitude range [-90,90] are successively bisected, interleaving the
+// resulting bits (longitude first) and grouping every 5 bits into one
+// base-32 character.
+func (p *Point) GeoHash(precision int) string {
+	lonRange := [2]float64{-180, 180}
+	latRange := [2]float64{-90, 90}
+
+	hash := make([]byte, precision)
+	isLon := true
+	bit, ch := 0, 0
+
+	for i := 0; i < precision; i++ {
+		for bit < 5 {
+			if isLon {
+				mid := (lonRange[0] + lonRange[1]) / 2
+				if p.Lon >= mid {
+					ch = ch<<1 | 1
+					lonRange[0] = mid
+				} else {
+					ch = ch << 1
+					lonRange[1] = mid
+				}
+			} else {
+				mid := (latRange[0] + latRange[1]) / 2
+				if p.Lat >= mid {
+					ch = ch<<1 | 1
+					latRange[0] = mid
+				} else {
+					ch = ch << 1
+					latRange[1] = mid
+				}
+			}
+			isLon = !isLon
+			bit++
+		}
+
+		hash[i] = geohashBase32[ch]
+		bit, ch = 0, 0
+	}
+
+	return string(hash)
+}
+
+// DecodeGeoHash decodes a base-32 geohash string into the southwest and
+// northeast corners of the cell it identifies. Characters outside the
+// geohash alphabet are ignored.
+func DecodeGeoHash(hash string) (sw, ne *Point) {
+	lonRange := [2]float64{-180, 180}
+	latRange := [2]float64{-90, 90}
+
+	isLon := true
+	for i := 0; i < len(hash); i++ {
+		idx := strings.IndexByte(geohashBase32, hash[i])
+		if idx < 0 {
+			continue
+		}
+
+		for n := 4; n >= 0; n-- {
+			bit := (idx >> uint(n)) & 1
+			if isLon {
+				mid := (lonRange[0] + lonRange[1]) / 2
+				if bit == 1 {
+					lonRange[0] = mid
+				} else {
+					lonRange[1] = mid
+				}
+			} else {
+				mid := (latRange[0] + latRange[1]) / 2
+				if bit == 1 {
+					latRange[0] = mid
+				} else {
+					latRange[1] = mid
+				}
+			}
+			isLon = !isLon
+		}
+	}
+
+	sw = &Point{Lat: latRange[0], Lon: lonRange[0]}
+	ne = &Point{Lat: latRange[1], Lon: lonRange[1]}
+	return sw, ne
+}
+
+// QuadKey encodes the Point as a Bing Maps-style quadkey string at the
+// given zoom level, for use as a key into quadtree-tiled map data.
+func (p *Point) QuadKey(zoom int) string {
+	latRad := p.Lat * math.Pi / 180.0
+	n := math.Exp2(float64(zoom))
+
+	x := int((p.Lon + 180.0) / 360.0 * n)
+	y := int((1 - math.Log(math.Tan(latRad)+1/math.Cos(latRad))/math.Pi) / 2 * n)
+
+	key := make([]byte, zoom)
+	for i := 0; i < zoom; i++ {
+		shift := uint(zoom - i - 1)
+
+		digit := 0
+		if (x>>shift)&1 != 0 {
+			digit++
+		}
+		if (y>>shift)&1 != 0 {
+			digit += 2
+		}
+
+		key[i] = byte('0' + digit)
+	}
+
+	return string(key)
+}
@@ -0,0 +1,66 @@
+package geo
+
+import "testing"
+
+// Ensures that GeoHash produces the well-known reference hash for a
+// Jutland, Denmark coordinate (https://en.wikipedia.org/wiki/Geohash).
+func TestGeoHashKnownValue(t *testing.T) {
+	p := &Point{Lat: 57.64911, Lon: 10.40744}
+
+	hash := p.GeoHash(11)
+	expected := "u4pruydqqvj"
+
+	if hash != expected {
+		t.Errorf("Expected geohash %q, but got %q", expected, hash)
+	}
+}
+
+// Ensures that decoding a geohash yields a cell that contains the
+// original point.
+func TestDecodeGeoHashRoundTrip(t *testing.T) {
+	p := &Point{Lat: 57.64911, Lon: 10.40744}
+	hash := p.GeoHash(9)
+
+	sw, ne := DecodeGeoHash(hash)
+
+	if p.Lat < sw.Lat || p.Lat > ne.Lat || p.Lon < sw.Lon || p.Lon > ne.Lon {
+		t.Errorf("Expected decoded cell [%v, %v] to contain the original point %v", sw, ne, p)
+	}
+}
+
+// Ensures that a longer geohash produces a tighter bounding cell than a
+// shorter one for the same point.
+func TestGeoHashPrecisionNarrowsCell(t *testing.T) {
+	p := &Point{Lat: 40.7486, Lon: -73.9864}
+
+	swCoarse, neCoarse := DecodeGeoHash(p.GeoHash(3))
+	swFine, neFine := DecodeGeoHash(p.GeoHash(8))
+
+	if (neFine.Lat - swFine.Lat) >= (neCoarse.Lat - swCoarse.Lat) {
+		t.Error("Expected a higher-precision geohash to produce a narrower latitude range")
+	}
+	if (neFine.Lon - swFine.Lon) >= (neCoarse.Lon - swCoarse.Lon) {
+		t.Error("Expected a higher-precision geohash to produce a narrower longitude range")
+	}
+}
+
+// Ensures that QuadKey produces the expected length and is stable for a
+// fixed point and zoom level.
+func TestQuadKey(t *testing.T) {
+	p := &Point{Lat: 47.6, Lon: -122.33}
+
+	key := p.QuadKey(10)
+	if len(key) != 10 {
+		t.Fatalf("Expected a quadkey of length 10, but got %q (length %d)", key, len(key))
+	}
+
+	for _, c := range key {
+		if c < '0' || c > '3' {
+			t.Errorf("Expected quadkey digits to be in ['0','3'], but got %q", c)
+		}
+	}
+
+	if key != p.QuadKey(10) {
+		t.Error("Expected QuadKey to be deterministic for the same point and zoom")
+	}
+}
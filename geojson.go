@@ -0,0 +1,158 @@
+package geo
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// geoJSONGeometry is the shape shared by every GeoJSON geometry object. It is
+// decoded first so the "type" field can be inspected before the coordinates
+// are unmarshalled into their final, geometry-specific form.
+type geoJSONGeometry struct {
+	Type        string          `json:"type"`
+	Coordinates json.RawMessage `json:"coordinates"`
+}
+
+// MarshalGeoJSON renders the current Point as a GeoJSON Point geometry,
+// e.g. {"type":"Point","coordinates":[lon,lat]}. Per RFC 7946, coordinates
+// are ordered longitude first.
+func (p *Point) MarshalGeoJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Type        string     `json:"type"`
+		Coordinates [2]float64 `json:"coordinates"`
+	}{
+		Type:        "Point",
+		Coordinates: [2]float64{p.Lon, p.Lat},
+	})
+}
+
+// UnmarshalGeoJSON decodes the current Point from a GeoJSON Point geometry.
+// Throws an error if the body is not a valid GeoJSON Point.
+func (p *Point) UnmarshalGeoJSON(data []byte) error {
+	var g geoJSONGeometry
+	if err := json.Unmarshal(data, &g); err != nil {
+		return err
+	}
+	if g.Type != "Point" {
+		return fmt.Errorf("expected GeoJSON type Point, got %q", g.Type)
+	}
+
+	var coords [2]float64
+	if err := json.Unmarshal(g.Coordinates, &coords); err != nil {
+		return fmt.Errorf("invalid Point coordinates: %v", err)
+	}
+
+	p.Lon, p.Lat = coords[0], coords[1]
+	return nil
+}
+
+// MarshalGeoJSON renders the current Polygon as a GeoJSON Polygon geometry,
+// e.g. {"type":"Polygon","coordinates":[[[lon,lat],...],[...]]}. The first
+// ring is the outer boundary; any further rings are interior holes.
+func (p *Polygon) MarshalGeoJSON() ([]byte, error) {
+	coords := make([][][2]float64, len(p.Rings))
+	for i, ring := range p.Rings {
+		coords[i] = make([][2]float64, len(ring))
+		for j, pt := range ring {
+			coords[i][j] = [2]float64{pt.Lon, pt.Lat}
+		}
+	}
+
+	return json.Marshal(struct {
+		Type        string         `json:"type"`
+		Coordinates [][][2]float64 `json:"coordinates"`
+	}{
+		Type:        "Polygon",
+		Coordinates: coords,
+	})
+}
+
+// UnmarshalGeoJSON decodes the current Polygon from a GeoJSON Polygon
+// geometry, including any interior holes. Throws an error if the body is
+// not a valid GeoJSON Polygon.
+func (p *Polygon) UnmarshalGeoJSON(data []byte) error {
+	var g geoJSONGeometry
+	if err := json.Unmarshal(data, &g); err != nil {
+		return err
+	}
+	if g.Type != "Polygon" {
+		return fmt.Errorf("expected GeoJSON type Polygon, got %q", g.Type)
+	}
+
+	var coords [][][2]float64
+	if err := json.Unmarshal(g.Coordinates, &coords); err != nil {
+		return fmt.Errorf("invalid Polygon coordinates: %v", err)
+	}
+	if len(coords) == 0 {
+		return fmt.Errorf("Polygon must have at least one ring")
+	}
+
+	rings := make([][]Point, len(coords))
+	for i, ring := range coords {
+		rings[i] = make([]Point, len(ring))
+		for j, c := range ring {
+			rings[i][j] = Point{Lon: c[0], Lat: c[1]}
+		}
+	}
+
+	*p = *NewPolygonWithHoles(rings)
+	return nil
+}
+
+// ParseGeoJSON decodes a raw GeoJSON document into a *Point or *Polygon. It
+// understands bare Point/Polygon geometries as well as Feature and
+// FeatureCollection wrappers, dispatching to the matching geometry
+// constructor. A FeatureCollection resolves to the geometry of its first
+// feature.
+func ParseGeoJSON(data []byte) (interface{}, error) {
+	var envelope struct {
+		Type     string          `json:"type"`
+		Geometry json.RawMessage `json:"geometry"`
+		Features json.RawMessage `json:"features"`
+	}
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		return nil, err
+	}
+
+	switch envelope.Type {
+	case "Feature":
+		return parseGeoJSONGeometry(envelope.Geometry)
+	case "FeatureCollection":
+		var features []struct {
+			Geometry json.RawMessage `json:"geometry"`
+		}
+		if err := json.Unmarshal(envelope.Features, &features); err != nil {
+			return nil, err
+		}
+		if len(features) == 0 {
+			return nil, fmt.Errorf("FeatureCollection has no features")
+		}
+		return parseGeoJSONGeometry(features[0].Geometry)
+	default:
+		return parseGeoJSONGeometry(data)
+	}
+}
+
+func parseGeoJSONGeometry(data []byte) (interface{}, error) {
+	var g geoJSONGeometry
+	if err := json.Unmarshal(data, &g); err != nil {
+		return nil, err
+	}
+
+	switch g.Type {
+	case "Point":
+		p := &Point{}
+		if err := p.UnmarshalGeoJSON(data); err != nil {
+			return nil, err
+		}
+		return p, nil
+	case "Polygon":
+		poly := &Polygon{}
+		if err := poly.UnmarshalGeoJSON(data); err != nil {
+			return nil, err
+		}
+		return poly, nil
+	default:
+		return nil, fmt.Errorf("unsupported GeoJSON geometry type %q", g.Type)
+	}
+}
@@ -0,0 +1,150 @@
+package geo
+
+import (
+	"testing"
+)
+
+// Ensures that a Point can be marshalled into GeoJSON, with coordinates
+// ordered [lon, lat] as required by RFC 7946.
+func TestPointMarshalGeoJSON(t *testing.T) {
+	p := NewPoint(40.7486, -73.9864)
+	res, err := p.MarshalGeoJSON()
+
+	if err != nil {
+		t.Error("Should not encounter an error when attempting to Marshal a Point to GeoJSON")
+	}
+
+	expected := `{"type":"Point","coordinates":[-73.9864,40.7486]}`
+	if string(res) != expected {
+		t.Errorf("Point should correctly Marshal to GeoJSON.\nExpected %s\nBut got %s", expected, res)
+	}
+}
+
+// Ensures that a Point can be unmarshalled from GeoJSON.
+func TestPointUnmarshalGeoJSON(t *testing.T) {
+	data := []byte(`{"type":"Point","coordinates":[-73.9864,40.7486]}`)
+	p := &Point{}
+	err := p.UnmarshalGeoJSON(data)
+
+	if err != nil {
+		t.Errorf("Should not encounter an error when attempting to Unmarshal a Point from GeoJSON")
+	}
+
+	if p.Lat != 40.7486 || p.Lon != -73.9864 {
+		t.Errorf("Point has mismatched data after Unmarshalling from GeoJSON")
+	}
+}
+
+// Ensures that UnmarshalGeoJSON rejects a geometry of the wrong type.
+func TestPointUnmarshalGeoJSONWrongType(t *testing.T) {
+	data := []byte(`{"type":"Polygon","coordinates":[]}`)
+	p := &Point{}
+	if err := p.UnmarshalGeoJSON(data); err == nil {
+		t.Error("Expected an error when Unmarshalling a non-Point geometry into a Point")
+	}
+}
+
+// Ensures that a Polygon can be marshalled into GeoJSON.
+func TestPolygonMarshalGeoJSON(t *testing.T) {
+	poly := NewPolygon([]Point{
+		{Lat: 0, Lon: 0},
+		{Lat: 0, Lon: 1},
+		{Lat: 1, Lon: 1},
+		{Lat: 1, Lon: 0},
+	})
+
+	res, err := poly.MarshalGeoJSON()
+	if err != nil {
+		t.Error("Should not encounter an error when attempting to Marshal a Polygon to GeoJSON")
+	}
+
+	expected := `{"type":"Polygon","coordinates":[[[0,0],[1,0],[1,1],[0,1]]]}`
+	if string(res) != expected {
+		t.Errorf("Polygon should correctly Marshal to GeoJSON.\nExpected %s\nBut got %s", expected, res)
+	}
+}
+
+// Ensures that a Polygon can be unmarshalled from GeoJSON.
+func TestPolygonUnmarshalGeoJSON(t *testing.T) {
+	data := []byte(`{"type":"Polygon","coordinates":[[[0,0],[1,0],[1,1],[0,1]]]}`)
+	poly := &Polygon{}
+	err := poly.UnmarshalGeoJSON(data)
+
+	if err != nil {
+		t.Errorf("Should not encounter an error when attempting to Unmarshal a Polygon from GeoJSON")
+	}
+
+	points := poly.Points()
+	if len(points) != 4 {
+		t.Fatalf("Expected 4 points after Unmarshalling, but got %d", len(points))
+	}
+
+	if points[0].Lat != 0 || points[0].Lon != 0 || points[2].Lat != 1 || points[2].Lon != 1 {
+		t.Errorf("Polygon has mismatched data after Unmarshalling from GeoJSON")
+	}
+}
+
+// Ensures that ParseGeoJSON dispatches a bare Point geometry correctly.
+func TestParseGeoJSONPoint(t *testing.T) {
+	data := []byte(`{"type":"Point","coordinates":[-73.9864,40.7486]}`)
+	geom, err := ParseGeoJSON(data)
+	if err != nil {
+		t.Fatal("Should not encounter an error when parsing a GeoJSON Point", err)
+	}
+
+	p, ok := geom.(*Point)
+	if !ok {
+		t.Fatalf("Expected a *Point, but got %T", geom)
+	}
+	if p.Lat != 40.7486 || p.Lon != -73.9864 {
+		t.Errorf("Parsed Point has mismatched data")
+	}
+}
+
+// Ensures that ParseGeoJSON unwraps a Feature to its underlying geometry.
+func TestParseGeoJSONFeature(t *testing.T) {
+	data := []byte(`{"type":"Feature","properties":{},"geometry":{"type":"Point","coordinates":[1,2]}}`)
+	geom, err := ParseGeoJSON(data)
+	if err != nil {
+		t.Fatal("Should not encounter an error when parsing a GeoJSON Feature", err)
+	}
+
+	p, ok := geom.(*Point)
+	if !ok {
+		t.Fatalf("Expected a *Point, but got %T", geom)
+	}
+	if p.Lat != 2 || p.Lon != 1 {
+		t.Errorf("Parsed Feature geometry has mismatched data")
+	}
+}
+
+// Ensures that ParseGeoJSON resolves a FeatureCollection to its first feature's geometry.
+func TestParseGeoJSONFeatureCollection(t *testing.T) {
+	data := []byte(`{"type":"FeatureCollection","features":[
+		{"type":"Feature","geometry":{"type":"Polygon","coordinates":[[[0,0],[1,0],[1,1],[0,1]]]}}
+	]}`)
+	geom, err := ParseGeoJSON(data)
+	if err != nil {
+		t.Fatal("Should not encounter an error when parsing a GeoJSON FeatureCollection", err)
+	}
+
+	if _, ok := geom.(*Polygon); !ok {
+		t.Fatalf("Expected a *Polygon, but got %T", geom)
+	}
+}
+
+// Ensures that ParseGeoJSON rejects an empty FeatureCollection.
+func TestParseGeoJSONEmptyFeatureCollection(t *testing.T) {
+	data := []byte(`{"type":"FeatureCollection","features":[]}`)
+	if _, err := ParseGeoJSON(data); err == nil {
+		t.Error("Expected an error when parsing a FeatureCollection with no features")
+	}
+}
+
+// Ensures that ParseGeoJSON rejects an unsupported geometry type.
+func TestParseGeoJSONUnsupportedType(t *testing.T) {
+	data := []byte(`{"type":"LineString","coordinates":[[0,0],[1,1]]}`)
+	if _, err := ParseGeoJSON(data); err == nil {
+		t.Error("Expected an error when parsing an unsupported GeoJSON geometry type")
+	}
+}
@@ -4,21 +4,35 @@ package geo
 
 // A Polygon is carved out of a 2D plane by a set of (possibly disjoint) contours.
 // It can thus contain holes, and can be self-intersecting.
+//
+// Rings holds one or more closed contours: the first ring is the polygon's
+// outer boundary, and any further rings describe holes cut out of it.
 type Polygon struct {
-	points []Point
+	Rings [][]Point
 }
 
 // NewPolygon Creates and returns a new pointer to a Polygon
-// composed of the passed in points.  Points are
-// considered to be in order such that the last point
-// forms an edge with the first point.
+// composed of the passed in points, treated as a single outer ring
+// with no holes. Points are considered to be in order such that the
+// last point forms an edge with the first point.
 func NewPolygon(points []Point) *Polygon {
-	return &Polygon{points: points}
+	return &Polygon{Rings: [][]Point{points}}
 }
 
-// Points returns the points of the current Polygon.
+// NewPolygonWithHoles creates and returns a new pointer to a Polygon whose
+// first ring is its outer boundary and whose remaining rings describe
+// interior holes.
+func NewPolygonWithHoles(rings [][]Point) *Polygon {
+	return &Polygon{Rings: rings}
+}
+
+// Points returns the points of the current Polygon's outer ring. Kept for
+// backward compatibility with single-ring Polygons.
 func (p *Polygon) Points() []Point {
-	return p.points
+	if len(p.Rings) == 0 {
+		return nil
+	}
+	return p.Rings[0]
 }
 
 // IsClosed returns whether or not the polygon is closed.
@@ -26,22 +40,39 @@ func (p *Polygon) Points() []Point {
 //        this should be sufficient for detecting if points
 //        are contained using the raycast algorithm.
 func (p *Polygon) IsClosed() bool {
-	if len(p.points) < 3 {
+	if len(p.Rings) == 0 || len(p.Rings[0]) < 3 {
 		return false
 	}
 
 	return true
 }
 
-// Contains returns whether or not the current Polygon contains the passed in Point.
+// Contains returns whether or not the current Polygon contains the passed in
+// Point. The even-odd raycast is run against every ring, so a point inside
+// the outer ring but also inside an interior hole counts as outside.
 func (p *Polygon) Contains(point *Point) bool {
 	if !p.IsClosed() {
 		return false
 	}
 
-	contains := PNPoly(point, &p.points[len(p.points)-1], &p.points[0])
-	for i := 1; i < len(p.points); i++ {
-		if PNPoly(point, &p.points[i-1], &p.points[i]) {
+	contains := ringContains(p.Rings[0], point)
+	for _, hole := range p.Rings[1:] {
+		if ringContains(hole, point) {
+			contains = false
+		}
+	}
+
+	return contains
+}
+
+func ringContains(ring []Point, point *Point) bool {
+	if len(ring) < 3 {
+		return false
+	}
+
+	contains := PNPoly(point, &ring[len(ring)-1], &ring[0])
+	for i := 1; i < len(ring); i++ {
+		if PNPoly(point, &ring[i-1], &ring[i]) {
 			contains = !contains
 		}
 	}
@@ -53,3 +84,105 @@ func PNPoly(p, a, b *Point) bool {
 	return (a.Lon > p.Lon) != (b.Lon > p.Lon) &&
 		p.Lat < (b.Lat-a.Lat)*(p.Lon-a.Lon)/(b.Lon-a.Lon)+a.Lat
 }
+
+// signedRingArea returns twice the signed planar area of a ring via the
+// shoelace formula, treating Lon as x and Lat as y. The sign is positive for
+// a counter-clockwise ring and negative for a clockwise one.
+func signedRingArea(ring []Point) float64 {
+	var area float64
+	n := len(ring)
+	for i := 0; i < n; i++ {
+		a := ring[i]
+		b := ring[(i+1)%n]
+		area += a.Lon*b.Lat - b.Lon*a.Lat
+	}
+
+	return area
+}
+
+func absFloat(f float64) float64 {
+	if f < 0 {
+		return -f
+	}
+	return f
+}
+
+// Area returns the planar area enclosed by the Polygon: the area of the
+// outer ring minus the area of each hole. It is a coordinate-plane
+// approximation in square degrees, not a great-circle surface area, and is
+// most accurate for polygons small enough that the Earth's curvature can be
+// ignored.
+func (p *Polygon) Area() float64 {
+	if len(p.Rings) == 0 {
+		return 0
+	}
+
+	area := absFloat(signedRingArea(p.Rings[0])) / 2
+	for _, hole := range p.Rings[1:] {
+		area -= absFloat(signedRingArea(hole)) / 2
+	}
+
+	return area
+}
+
+// Centroid returns the area-weighted centroid of the Polygon's outer ring.
+// Holes are ignored. Returns nil if the outer ring is degenerate.
+func (p *Polygon) Centroid() *Point {
+	if len(p.Rings) == 0 {
+		return nil
+	}
+
+	ring := p.Rings[0]
+	n := len(ring)
+	if n < 3 {
+		return nil
+	}
+
+	var signedArea, lon, lat float64
+	for i := 0; i < n; i++ {
+		a := ring[i]
+		b := ring[(i+1)%n]
+		cross := a.Lon*b.Lat - b.Lon*a.Lat
+		signedArea += cross
+		lon += (a.Lon + b.Lon) * cross
+		lat += (a.Lat + b.Lat) * cross
+	}
+	signedArea /= 2
+
+	if signedArea == 0 {
+		return nil
+	}
+
+	return NewPoint(lat/(6*signedArea), lon/(6*signedArea))
+}
+
+// IsClockwise returns whether the passed in ring is wound clockwise.
+func IsClockwise(ring []Point) bool {
+	return signedRingArea(ring) < 0
+}
+
+// IsClockwise returns whether the Polygon's outer ring is wound clockwise.
+func (p *Polygon) IsClockwise() bool {
+	if len(p.Rings) == 0 {
+		return false
+	}
+	return IsClockwise(p.Rings[0])
+}
+
+// Normalize reorders the Polygon's rings in place to follow GeoJSON's
+// winding convention: the outer ring counter-clockwise, and every interior
+// hole clockwise.
+func (p *Polygon) Normalize() {
+	for i, ring := range p.Rings {
+		wantClockwise := i != 0
+		if IsClockwise(ring) != wantClockwise {
+			reverseRing(ring)
+		}
+	}
+}
+
+func reverseRing(ring []Point) {
+	for i, j := 0, len(ring)-1; i < j; i, j = i+1, j-1 {
+		ring[i], ring[j] = ring[j], ring[i]
+	}
+}
@@ -0,0 +1,116 @@
+package geo
+
+import "testing"
+
+// A simple size x size square, (0,0) to (size,size), wound counter-clockwise.
+func square(size float64) []Point {
+	return []Point{
+		{Lat: 0, Lon: 0},
+		{Lat: 0, Lon: size},
+		{Lat: size, Lon: size},
+		{Lat: size, Lon: 0},
+	}
+}
+
+// Ensures that NewPolygon keeps working as a single-ring shim.
+func TestNewPolygonSingleRing(t *testing.T) {
+	poly := NewPolygon(square(10))
+
+	if len(poly.Rings) != 1 {
+		t.Fatalf("Expected NewPolygon to produce a single ring, but got %d", len(poly.Rings))
+	}
+
+	if !poly.Contains(&Point{Lat: 5, Lon: 5}) {
+		t.Error("Expected the polygon to contain its center point")
+	}
+}
+
+// Ensures that Contains treats a point inside a hole as outside the Polygon.
+func TestPolygonContainsWithHole(t *testing.T) {
+	outer := square(10)
+	hole := []Point{
+		{Lat: 4, Lon: 4},
+		{Lat: 4, Lon: 6},
+		{Lat: 6, Lon: 6},
+		{Lat: 6, Lon: 4},
+	}
+
+	poly := NewPolygonWithHoles([][]Point{outer, hole})
+
+	if poly.Contains(&Point{Lat: 5, Lon: 5}) {
+		t.Error("Expected a point inside the hole to be considered outside the polygon")
+	}
+
+	if !poly.Contains(&Point{Lat: 1, Lon: 1}) {
+		t.Error("Expected a point inside the outer ring but outside the hole to be considered inside the polygon")
+	}
+
+	if poly.Contains(&Point{Lat: 20, Lon: 20}) {
+		t.Error("Expected a point outside the outer ring to be considered outside the polygon")
+	}
+}
+
+// Ensures that Area computes the area of the outer ring minus its holes.
+func TestPolygonArea(t *testing.T) {
+	outer := square(10)
+	hole := square(4)
+
+	poly := NewPolygonWithHoles([][]Point{outer, hole})
+
+	expected := 100.0 - 16.0
+	if poly.Area() != expected {
+		t.Errorf("Expected area of %f, but got %f", expected, poly.Area())
+	}
+}
+
+// Ensures that Centroid returns the center of a simple square.
+func TestPolygonCentroid(t *testing.T) {
+	poly := NewPolygon(square(10))
+
+	c := poly.Centroid()
+	if c == nil {
+		t.Fatal("Expected a non-nil centroid")
+	}
+
+	if c.Lat != 5 || c.Lon != 5 {
+		t.Errorf("Expected centroid of (5, 5), but got (%f, %f)", c.Lat, c.Lon)
+	}
+}
+
+// Ensures that IsClockwise correctly identifies winding direction.
+func TestPolygonIsClockwise(t *testing.T) {
+	ccw := NewPolygon(square(10))
+	if ccw.IsClockwise() {
+		t.Error("Expected the square ring to be counter-clockwise")
+	}
+
+	cw := &Polygon{Rings: [][]Point{reversed(square(10))}}
+	if !cw.IsClockwise() {
+		t.Error("Expected the reversed ring to be clockwise")
+	}
+}
+
+// Ensures that Normalize enforces CCW exterior + CW interior rings.
+func TestPolygonNormalize(t *testing.T) {
+	outer := reversed(square(10))
+	hole := square(4)
+
+	poly := NewPolygonWithHoles([][]Point{outer, hole})
+	poly.Normalize()
+
+	if poly.IsClockwise() {
+		t.Error("Expected the outer ring to be counter-clockwise after Normalize")
+	}
+
+	if !IsClockwise(poly.Rings[1]) {
+		t.Error("Expected the hole to be clockwise after Normalize")
+	}
+}
+
+func reversed(points []Point) []Point {
+	out := make([]Point, len(points))
+	for i, p := range points {
+		out[len(points)-1-i] = p
+	}
+	return out
+}
@@ -0,0 +1,354 @@
+package geo
+
+import (
+	"math"
+	"sort"
+)
+
+// rtreeEntry pairs a Polygon with the lat/lon bounding box (MBR) used to
+// index it, computed once up front via Polygon.BoundingBox, which handles
+// rings that cross the antimeridian. A ring that encloses a pole is boxed by
+// its own latitudes only; its box does not extend to the pole.
+type rtreeEntry struct {
+	polygon     *Polygon
+	topLeft     *Point
+	bottomRight *Point
+}
+
+// rtreeLeaf groups a handful of entries under a single bounding box, so
+// that queries can reject the whole leaf without inspecting every entry
+// inside it.
+type rtreeLeaf struct {
+	entries     []rtreeEntry
+	topLeft     *Point
+	bottomRight *Point
+}
+
+// RTree is a spatial index over Polygons, bulk-loaded with the Sort-Tile-
+// Recursive (STR) algorithm. It lets Search, Contains, and NearestK reject
+// most polygons using a cheap bounding-box check before falling back to
+// the exact (and more expensive) Polygon.Contains raycast or
+// GreatCircleDistance.
+type RTree struct {
+	leaves []rtreeLeaf
+}
+
+// NewRTree bulk-loads an RTree over the given polygons, packing them into
+// leaves of roughly leafSize polygons each via STR packing. Polygons with
+// no points are skipped. leafSize defaults to 16 if less than 1.
+func NewRTree(polygons []*Polygon, leafSize int) *RTree {
+	if leafSize < 1 {
+		leafSize = 16
+	}
+
+	entries := make([]rtreeEntry, 0, len(polygons))
+	for _, poly := range polygons {
+		tl, br := poly.BoundingBox()
+		if tl == nil {
+			continue
+		}
+		entries = append(entries, rtreeEntry{polygon: poly, topLeft: tl, bottomRight: br})
+	}
+
+	return &RTree{leaves: strPack(entries, leafSize)}
+}
+
+// strPack groups entries into leaves using the STR algorithm: entries are
+// sliced into vertical strips by longitude, then each strip is sorted by
+// latitude and cut into leaves of leafSize.
+func strPack(entries []rtreeEntry, leafSize int) []rtreeLeaf {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	leafCount := int(math.Ceil(float64(len(entries)) / float64(leafSize)))
+	stripCount := int(math.Ceil(math.Sqrt(float64(leafCount))))
+	stripSize := int(math.Ceil(float64(len(entries)) / float64(stripCount)))
+
+	sort.Slice(entries, func(i, j int) bool {
+		return lonCenter(entries[i]) < lonCenter(entries[j])
+	})
+
+	var leaves []rtreeLeaf
+	for i := 0; i < len(entries); i += stripSize {
+		end := i + stripSize
+		if end > len(entries) {
+			end = len(entries)
+		}
+		strip := entries[i:end]
+
+		sort.Slice(strip, func(a, b int) bool {
+			return latCenter(strip[a]) < latCenter(strip[b])
+		})
+
+		for j := 0; j < len(strip); j += leafSize {
+			leafEnd := j + leafSize
+			if leafEnd > len(strip) {
+				leafEnd = len(strip)
+			}
+			leaves = append(leaves, newRTreeLeaf(strip[j:leafEnd]))
+		}
+	}
+
+	return leaves
+}
+
+func lonCenter(e rtreeEntry) float64 {
+	return (e.topLeft.Lon + e.bottomRight.Lon) / 2
+}
+
+func latCenter(e rtreeEntry) float64 {
+	return (e.topLeft.Lat + e.bottomRight.Lat) / 2
+}
+
+func newRTreeLeaf(entries []rtreeEntry) rtreeLeaf {
+	leaf := rtreeLeaf{
+		entries:     entries,
+		topLeft:     &Point{Lat: entries[0].topLeft.Lat, Lon: entries[0].topLeft.Lon},
+		bottomRight: &Point{Lat: entries[0].bottomRight.Lat, Lon: entries[0].bottomRight.Lon},
+	}
+
+	for _, e := range entries[1:] {
+		if e.topLeft.Lat > leaf.topLeft.Lat {
+			leaf.topLeft.Lat = e.topLeft.Lat
+		}
+		if e.topLeft.Lon < leaf.topLeft.Lon {
+			leaf.topLeft.Lon = e.topLeft.Lon
+		}
+		if e.bottomRight.Lat < leaf.bottomRight.Lat {
+			leaf.bottomRight.Lat = e.bottomRight.Lat
+		}
+		if e.bottomRight.Lon > leaf.bottomRight.Lon {
+			leaf.bottomRight.Lon = e.bottomRight.Lon
+		}
+	}
+
+	// A min/max over wrapped entries (topLeft.Lon > bottomRight.Lon) can
+	// yield a box that excludes them, so a leaf holding one spans every
+	// longitude.
+	for _, e := range entries {
+		if e.topLeft.Lon > e.bottomRight.Lon {
+			leaf.topLeft.Lon, leaf.bottomRight.Lon = -180, 180
+			break
+		}
+	}
+
+	return leaf
+}
+
+func boxesOverlap(aTL, aBR, bTL, bBR *Point) bool {
+	if aTL.Lat < bBR.Lat || aBR.Lat > bTL.Lat {
+		return false
+	}
+
+	for _, a := range lonRanges(aTL.Lon, aBR.Lon) {
+		for _, b := range lonRanges(bTL.Lon, bBR.Lon) {
+			if a[0] <= b[1] && b[0] <= a[1] {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// lonRanges splits a possibly-wrapped [min,max] longitude range (min > max
+// means it crosses the antimeridian) into one or two normal ranges.
+func lonRanges(min, max float64) [][2]float64 {
+	if min <= max {
+		return [][2]float64{{min, max}}
+	}
+	return [][2]float64{{min, 180}, {-180, max}}
+}
+
+// Search returns every Polygon in the tree whose bounding box overlaps the
+// given box.
+func (t *RTree) Search(topLeft, bottomRight *Point) []*Polygon {
+	var results []*Polygon
+	for _, leaf := range t.leaves {
+		if !boxesOverlap(leaf.topLeft, leaf.bottomRight, topLeft, bottomRight) {
+			continue
+		}
+		for _, e := range leaf.entries {
+			if boxesOverlap(e.topLeft, e.bottomRight, topLeft, bottomRight) {
+				results = append(results, e.polygon)
+			}
+		}
+	}
+	return results
+}
+
+// Contains returns every Polygon in the tree that contains p. Entries are
+// pre-filtered by their bounding box so that the exact Polygon.Contains
+// raycast only runs for polygons whose MBR could possibly contain p.
+func (t *RTree) Contains(p *Point) []*Polygon {
+	var results []*Polygon
+	for _, leaf := range t.leaves {
+		if !BoxContains(leaf.topLeft, leaf.bottomRight, p) {
+			continue
+		}
+		for _, e := range leaf.entries {
+			if BoxContains(e.topLeft, e.bottomRight, p) && e.polygon.Contains(p) {
+				results = append(results, e.polygon)
+			}
+		}
+	}
+	return results
+}
+
+// NearestK returns up to k Polygons in the tree nearest to p, ranked by the
+// great-circle distance from p to each Polygon's centroid.
+//
+// Leaves are visited in order of their distanceToBox lower bound, nearest
+// first, and a running top-k is maintained as a sorted slice. Once k
+// candidates have been found, any leaf whose lower bound already exceeds the
+// current k-th best distance - and every leaf after it, since they're sorted
+// - is skipped without scoring its entries, so a query over an index with
+// thousands of polygons only ever touches the leaves near p.
+func (t *RTree) NearestK(p *Point, k int) []*Polygon {
+	if k < 0 {
+		k = 0
+	}
+	if k == 0 || len(t.leaves) == 0 {
+		return []*Polygon{}
+	}
+
+	type leafBound struct {
+		leaf    *rtreeLeaf
+		minDist float64
+	}
+
+	bounds := make([]leafBound, len(t.leaves))
+	for i := range t.leaves {
+		bounds[i] = leafBound{
+			leaf:    &t.leaves[i],
+			minDist: distanceToBox(p, t.leaves[i].topLeft, t.leaves[i].bottomRight),
+		}
+	}
+	sort.Slice(bounds, func(i, j int) bool {
+		return bounds[i].minDist < bounds[j].minDist
+	})
+
+	type scored struct {
+		polygon  *Polygon
+		distance float64
+	}
+
+	var best []scored
+	for _, lb := range bounds {
+		if len(best) >= k && lb.minDist > best[len(best)-1].distance {
+			break
+		}
+
+		for _, e := range lb.leaf.entries {
+			centroid := e.polygon.Centroid()
+			if centroid == nil {
+				continue
+			}
+
+			d := p.GreatCircleDistance(centroid)
+			if len(best) >= k && d >= best[len(best)-1].distance {
+				continue
+			}
+
+			i := sort.Search(len(best), func(i int) bool { return best[i].distance > d })
+			best = append(best, scored{})
+			copy(best[i+1:], best[i:])
+			best[i] = scored{polygon: e.polygon, distance: d}
+			if len(best) > k {
+				best = best[:k]
+			}
+		}
+	}
+
+	results := make([]*Polygon, len(best))
+	for i, s := range best {
+		results[i] = s.polygon
+	}
+	return results
+}
+
+// distanceToBox returns a true lower bound on the great-circle distance from
+// p to any point inside the box defined by topLeft and bottomRight (as
+// returned by Polygon.BoundingBox).
+//
+// GreatCircleDistance's haversine term a = sin²(dLat/2) + sin²(dLon/2) *
+// cos(lat1) * cos(lat2) is a sum/product of non-negative factors, so a is
+// bounded below by combining each factor's own minimum over the box: the
+// smallest sin²(dLat/2) reachable by any lat2 in the box (at the latitude
+// closest to p.Lat, clamped into the box), the smallest sin²(dLon/2)
+// reachable by any lon2 in the box (at the longitude closest to p.Lon,
+// clamped with lonRanges-style wraparound handling), and the smallest
+// cos(lat2) reachable by any lat2 in the box (cos is concave, so its
+// minimum over an interval is always at one of the two endpoints). No
+// single point in the box need achieve all three minima at once for this
+// combined value to still be a valid lower bound on a, because each factor
+// in the sum/product is individually no smaller than it.
+//
+// The distance from p to the single point obtained by clamping p's own lat
+// and lon into the box is not used: that point is itself inside the box, so
+// its distance is only an upper bound on the box's true minimum distance.
+// Near the poles, where meridians converge, the true closest point in a
+// lat/lon box is frequently at a different latitude than p.Lat when only
+// p's longitude falls outside the box, and that distance overestimates the
+// bound enough to prune leaves that hold a strictly closer polygon.
+func distanceToBox(p, topLeft, bottomRight *Point) float64 {
+	const deg2rad = math.Pi / 180.0
+
+	lat1 := p.Lat * deg2rad
+	latMin := bottomRight.Lat * deg2rad
+	latMax := topLeft.Lat * deg2rad
+
+	latClamped := clamp(p.Lat, bottomRight.Lat, topLeft.Lat) * deg2rad
+	dLat := latClamped - lat1
+	sin2DLat := math.Sin(dLat/2) * math.Sin(dLat/2)
+
+	lonClamped := closestLonInBox(p.Lon, topLeft.Lon, bottomRight.Lon)
+	dLon := (lonClamped - p.Lon) * deg2rad
+	sin2DLon := math.Sin(dLon/2) * math.Sin(dLon/2)
+
+	minCosLat2 := math.Min(math.Cos(latMin), math.Cos(latMax))
+
+	a := sin2DLat + sin2DLon*math.Cos(lat1)*minCosLat2
+	if a > 1 {
+		a = 1
+	} else if a < 0 {
+		a = 0
+	}
+
+	c := 2 * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
+	return EarthRadius * c
+}
+
+// closestLonInBox returns the longitude within the (possibly
+// antimeridian-wrapped, topLeftLon > bottomRightLon) range [topLeftLon,
+// bottomRightLon] closest to lon, measured the short way around the globe,
+// or lon itself if it already falls inside.
+func closestLonInBox(lon, topLeftLon, bottomRightLon float64) float64 {
+	if topLeftLon <= bottomRightLon {
+		if lon >= topLeftLon && lon <= bottomRightLon {
+			return lon
+		}
+	} else if lon >= topLeftLon || lon <= bottomRightLon {
+		return lon
+	}
+
+	toBottomRight := math.Abs(lon - bottomRightLon)
+	toBottomRight = math.Min(toBottomRight, 360-toBottomRight)
+	toTopLeft := math.Abs(topLeftLon - lon)
+	toTopLeft = math.Min(toTopLeft, 360-toTopLeft)
+	if toBottomRight < toTopLeft {
+		return bottomRightLon
+	}
+	return topLeftLon
+}
+
+func clamp(v, min, max float64) float64 {
+	if v < min {
+		return min
+	}
+	if v > max {
+		return max
+	}
+	return v
+}
@@ -0,0 +1,157 @@
+package geo
+
+import "testing"
+
+func unitSquareAt(lat, lon float64) *Polygon {
+	return NewPolygon([]Point{
+		{Lat: lat, Lon: lon},
+		{Lat: lat, Lon: lon + 1},
+		{Lat: lat + 1, Lon: lon + 1},
+		{Lat: lat + 1, Lon: lon},
+	})
+}
+
+// Ensures that Search returns only the polygons whose bounding box
+// overlaps the query box.
+func TestRTreeSearch(t *testing.T) {
+	polygons := []*Polygon{
+		unitSquareAt(0, 0),
+		unitSquareAt(10, 10),
+		unitSquareAt(50, 50),
+	}
+
+	tree := NewRTree(polygons, 2)
+
+	results := tree.Search(&Point{Lat: 11, Lon: -1}, &Point{Lat: -1, Lon: 11})
+	if len(results) != 2 {
+		t.Fatalf("Expected 2 overlapping polygons, but got %d", len(results))
+	}
+}
+
+// Ensures that Contains returns the polygon actually containing the point,
+// and excludes polygons whose bounding box doesn't contain it.
+func TestRTreeContains(t *testing.T) {
+	polygons := []*Polygon{
+		unitSquareAt(0, 0),
+		unitSquareAt(10, 10),
+		unitSquareAt(50, 50),
+	}
+
+	tree := NewRTree(polygons, 2)
+
+	results := tree.Contains(&Point{Lat: 10.5, Lon: 10.5})
+	if len(results) != 1 {
+		t.Fatalf("Expected exactly 1 containing polygon, but got %d", len(results))
+	}
+
+	if len(tree.Contains(&Point{Lat: 99, Lon: 99})) != 0 {
+		t.Error("Expected no polygons to contain a far-away point")
+	}
+}
+
+// Ensures that NearestK returns the closest polygons in ascending order of
+// distance from the query point.
+func TestRTreeNearestK(t *testing.T) {
+	near := unitSquareAt(0, 0)
+	mid := unitSquareAt(10, 10)
+	far := unitSquareAt(50, 50)
+
+	tree := NewRTree([]*Polygon{far, mid, near}, 2)
+
+	results := tree.NearestK(&Point{Lat: 0.5, Lon: 0.5}, 2)
+	if len(results) != 2 {
+		t.Fatalf("Expected 2 results, but got %d", len(results))
+	}
+
+	if results[0] != near {
+		t.Error("Expected the nearest polygon to be returned first")
+	}
+	if results[1] != mid {
+		t.Error("Expected the second-nearest polygon to be returned second")
+	}
+}
+
+// Ensures that NearestK clamps k to the number of indexed polygons.
+func TestRTreeNearestKClampsToSize(t *testing.T) {
+	tree := NewRTree([]*Polygon{unitSquareAt(0, 0)}, 16)
+
+	results := tree.NearestK(&Point{Lat: 0, Lon: 0}, 5)
+	if len(results) != 1 {
+		t.Errorf("Expected NearestK to clamp to 1 result, but got %d", len(results))
+	}
+}
+
+// Ensures that NearestK treats a negative k as 0 rather than panicking.
+func TestRTreeNearestKNegative(t *testing.T) {
+	tree := NewRTree([]*Polygon{unitSquareAt(0, 0), unitSquareAt(10, 10)}, 2)
+
+	results := tree.NearestK(&Point{Lat: 0, Lon: 0}, -1)
+	if len(results) != 0 {
+		t.Errorf("Expected NearestK to return no results for a negative k, but got %d", len(results))
+	}
+}
+
+// Ensures that NearestK doesn't prune a leaf whose bounding box spans up to
+// a high latitude, at a longitude offset from p, when that leaf actually
+// holds a closer polygon than the current best. Clamping p's own lat and
+// lon independently into the box (instead of computing a true lower bound)
+// systematically overestimates the minimum distance here, because meridian
+// convergence near the pole means the box's true closest point is at a
+// latitude other than p's own.
+func TestRTreeNearestKPolarLongitudeOffset(t *testing.T) {
+	p := &Point{Lat: 75, Lon: 0}
+
+	// near's ring reaches from lat 75 up to lat 89.9 (near-zero-area spikes
+	// at the top and bottom contribute negligible weight to the
+	// area-weighted centroid), but its bulk - and so its centroid - sits at
+	// (~77.4, ~30.5), the true closest region of the box to p. Clamping p's
+	// own lat 75 into the box instead gives (75, 30), which is farther from
+	// p than the true closest point and farther than near's actual centroid.
+	near := NewPolygon([]Point{
+		{Lat: 75, Lon: 30.0001},
+		{Lat: 76.9, Lon: 30},
+		{Lat: 76.9, Lon: 31},
+		{Lat: 77.9, Lon: 31},
+		{Lat: 77.9, Lon: 30},
+		{Lat: 89.9, Lon: 30.0002},
+	})
+	decoy := unitSquareAt(75, -30.8)
+
+	if d := p.GreatCircleDistance(near.Centroid()); d >= p.GreatCircleDistance(decoy.Centroid()) {
+		t.Fatalf("test setup invalid: near's centroid distance %f should be less than decoy's", d)
+	}
+
+	tree := NewRTree([]*Polygon{near, decoy}, 1)
+
+	results := tree.NearestK(p, 1)
+	if len(results) != 1 {
+		t.Fatalf("Expected 1 result, but got %d", len(results))
+	}
+	if results[0] != near {
+		t.Error("Expected the polygon with the closer true centroid distance to be returned, not the longitude-clamped decoy")
+	}
+}
+
+// Ensures that NearestK still finds the correct nearest polygons when the
+// index has many leaves, so leaf-bound pruning doesn't skip a leaf that
+// actually holds a closer candidate.
+func TestRTreeNearestKManyLeaves(t *testing.T) {
+	var polygons []*Polygon
+	for lat := 0; lat < 20; lat++ {
+		for lon := 0; lon < 20; lon++ {
+			polygons = append(polygons, unitSquareAt(float64(lat*3), float64(lon*3)))
+		}
+	}
+	target := unitSquareAt(100, 100)
+	polygons = append(polygons, target)
+
+	tree := NewRTree(polygons, 4)
+
+	results := tree.NearestK(&Point{Lat: 100.5, Lon: 100.5}, 1)
+	if len(results) != 1 {
+		t.Fatalf("Expected 1 result, but got %d", len(results))
+	}
+	if results[0] != target {
+		t.Error("Expected the exact match at the query point to be returned as the nearest polygon")
+	}
+}
@@ -0,0 +1,106 @@
+package geo
+
+import (
+	"math"
+	"testing"
+)
+
+// Ensures that SloppyDistance stays within a small relative error of
+// GreatCircleDistance across a spread of point pairs.
+func TestSloppyDistanceAccuracy(t *testing.T) {
+	pairs := [][2]Point{
+		{{Lat: 47.4489, Lon: -122.3094}, {Lat: 37.6160933, Lon: -122.3924223}},
+		{{Lat: 40.7486, Lon: -73.9864}, {Lat: 0.0, Lon: 0.0}},
+		{{Lat: 52.205, Lon: 0.119}, {Lat: 48.857, Lon: 2.351}},
+		{{Lat: -33.8688, Lon: 151.2093}, {Lat: 35.6762, Lon: 139.6503}},
+		{{Lat: 89.0, Lon: 10.0}, {Lat: 85.0, Lon: -170.0}},
+	}
+
+	for _, pair := range pairs {
+		p1, p2 := pair[0], pair[1]
+
+		exact := p1.GreatCircleDistance(&p2)
+		sloppy := p1.SloppyDistance(&p2)
+
+		relErr := math.Abs(sloppy-exact) / math.Max(exact, 1)
+		if relErr > 2e-5 {
+			t.Errorf("SloppyDistance(%v, %v) = %f, GreatCircleDistance = %f, relative error %f exceeds tolerance",
+				p1, p2, sloppy, exact, relErr)
+		}
+	}
+}
+
+// Ensures that DistancesFrom stays within a small relative error of
+// GreatCircleDistance for each point, same as SloppyDistance.
+func TestDistancesFromAccuracy(t *testing.T) {
+	origin := &Point{Lat: 40.7486, Lon: -73.9864}
+	pts := []Point{
+		{Lat: 51.5074, Lon: -0.1278},
+		{Lat: 35.6762, Lon: 139.6503},
+		{Lat: -33.8688, Lon: 151.2093},
+	}
+
+	out := make([]float64, len(pts))
+	DistancesFrom(origin, pts, out)
+
+	for i, pt := range pts {
+		exact := origin.GreatCircleDistance(&pt)
+		relErr := math.Abs(out[i]-exact) / math.Max(exact, 1)
+		if relErr > 2e-5 {
+			t.Errorf("DistancesFrom[%d] = %f, GreatCircleDistance = %f, relative error %f exceeds tolerance",
+				i, out[i], exact, relErr)
+		}
+	}
+}
+
+// Ensures that SloppyDistance stays accurate in absolute terms even for
+// points close enough together that relative error isn't a meaningful
+// measure.
+func TestSloppyDistanceAccuracyShortRange(t *testing.T) {
+	p1 := &Point{Lat: 40.7486, Lon: -73.9864}
+	p2 := &Point{Lat: 40.7496, Lon: -73.9854}
+
+	exact := p1.GreatCircleDistance(p2)
+	sloppy := p1.SloppyDistance(p2)
+
+	if math.Abs(sloppy-exact) > 0.01 {
+		t.Errorf("SloppyDistance(%v, %v) = %f, GreatCircleDistance = %f, absolute error exceeds 10 meters",
+			p1, p2, sloppy, exact)
+	}
+}
+
+var benchDistResult float64
+
+func BenchmarkGreatCircleDistance(b *testing.B) {
+	sea := &Point{Lat: 47.4489, Lon: -122.3094}
+	sfo := &Point{Lat: 37.6160933, Lon: -122.3924223}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchDistResult = sea.GreatCircleDistance(sfo)
+	}
+}
+
+func BenchmarkSloppyDistance(b *testing.B) {
+	sea := &Point{Lat: 47.4489, Lon: -122.3094}
+	sfo := &Point{Lat: 37.6160933, Lon: -122.3924223}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchDistResult = sea.SloppyDistance(sfo)
+	}
+}
+
+func BenchmarkDistancesFrom(b *testing.B) {
+	origin := &Point{Lat: 47.4489, Lon: -122.3094}
+	pts := make([]Point, 1000)
+	for i := range pts {
+		pts[i] = Point{Lat: float64(i%180) - 90, Lon: float64(i%360) - 180}
+	}
+	out := make([]float64, len(pts))
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		DistancesFrom(origin, pts, out)
+	}
+}
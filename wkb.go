@@ -0,0 +1,190 @@
+package geo
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+)
+
+const (
+	wkbPointType   uint32 = 1
+	wkbPolygonType uint32 = 3
+)
+
+// MarshalWKB renders the current Point as Well-Known Binary (WKB): a
+// 1-byte byte-order flag (1 for little-endian), a uint32 geometry type
+// (1 for Point), then its X (longitude) and Y (latitude) as float64s.
+func (p *Point) MarshalWKB() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := buf.WriteByte(1); err != nil {
+		return nil, err
+	}
+	if err := binary.Write(&buf, binary.LittleEndian, wkbPointType); err != nil {
+		return nil, fmt.Errorf("unable to encode WKB geometry type: %v", err)
+	}
+	if err := binary.Write(&buf, binary.LittleEndian, p.Lon); err != nil {
+		return nil, fmt.Errorf("unable to encode lon %v: %v", p.Lon, err)
+	}
+	if err := binary.Write(&buf, binary.LittleEndian, p.Lat); err != nil {
+		return nil, fmt.Errorf("unable to encode lat %v: %v", p.Lat, err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalWKB decodes the current Point from a Well-Known Binary (WKB)
+// Point geometry.
+func (p *Point) UnmarshalWKB(data []byte) error {
+	buf := bytes.NewReader(data)
+
+	order, err := readWKBByteOrder(buf)
+	if err != nil {
+		return err
+	}
+
+	geomType, err := readWKBUint32(buf, order)
+	if err != nil {
+		return fmt.Errorf("unable to decode WKB geometry type: %v", err)
+	}
+	if geomType != wkbPointType {
+		return fmt.Errorf("expected WKB geometry type Point (1), got %d", geomType)
+	}
+
+	lon, err := readWKBFloat64(buf, order)
+	if err != nil {
+		return fmt.Errorf("unable to decode lon: %v", err)
+	}
+	lat, err := readWKBFloat64(buf, order)
+	if err != nil {
+		return fmt.Errorf("unable to decode lat: %v", err)
+	}
+
+	p.Lon, p.Lat = lon, lat
+	return nil
+}
+
+// MarshalWKB renders the current Polygon as Well-Known Binary (WKB): a
+// 1-byte byte-order flag, a uint32 geometry type (3 for Polygon), a uint32
+// ring count, then for each ring a uint32 point count followed by its
+// points as (lon, lat) float64 pairs. The first ring is the outer
+// boundary; any further rings are interior holes.
+func (p *Polygon) MarshalWKB() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := buf.WriteByte(1); err != nil {
+		return nil, err
+	}
+	if err := binary.Write(&buf, binary.LittleEndian, wkbPolygonType); err != nil {
+		return nil, fmt.Errorf("unable to encode WKB geometry type: %v", err)
+	}
+	if err := binary.Write(&buf, binary.LittleEndian, uint32(len(p.Rings))); err != nil {
+		return nil, fmt.Errorf("unable to encode WKB ring count: %v", err)
+	}
+
+	for _, ring := range p.Rings {
+		if err := binary.Write(&buf, binary.LittleEndian, uint32(len(ring))); err != nil {
+			return nil, fmt.Errorf("unable to encode WKB ring point count: %v", err)
+		}
+		for _, pt := range ring {
+			if err := binary.Write(&buf, binary.LittleEndian, pt.Lon); err != nil {
+				return nil, fmt.Errorf("unable to encode lon %v: %v", pt.Lon, err)
+			}
+			if err := binary.Write(&buf, binary.LittleEndian, pt.Lat); err != nil {
+				return nil, fmt.Errorf("unable to encode lat %v: %v", pt.Lat, err)
+			}
+		}
+	}
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalWKB decodes the current Polygon from a Well-Known Binary (WKB)
+// Polygon geometry, including any interior holes.
+func (p *Polygon) UnmarshalWKB(data []byte) error {
+	buf := bytes.NewReader(data)
+
+	order, err := readWKBByteOrder(buf)
+	if err != nil {
+		return err
+	}
+
+	geomType, err := readWKBUint32(buf, order)
+	if err != nil {
+		return fmt.Errorf("unable to decode WKB geometry type: %v", err)
+	}
+	if geomType != wkbPolygonType {
+		return fmt.Errorf("expected WKB geometry type Polygon (3), got %d", geomType)
+	}
+
+	ringCount, err := readWKBUint32(buf, order)
+	if err != nil {
+		return fmt.Errorf("unable to decode WKB ring count: %v", err)
+	}
+	if err := checkWKBCount(ringCount, 4, buf.Len()); err != nil {
+		return fmt.Errorf("invalid WKB ring count: %v", err)
+	}
+
+	rings := make([][]Point, ringCount)
+	for i := range rings {
+		pointCount, err := readWKBUint32(buf, order)
+		if err != nil {
+			return fmt.Errorf("unable to decode WKB ring point count: %v", err)
+		}
+		if err := checkWKBCount(pointCount, 16, buf.Len()); err != nil {
+			return fmt.Errorf("invalid WKB ring point count: %v", err)
+		}
+
+		ring := make([]Point, pointCount)
+		for j := range ring {
+			lon, err := readWKBFloat64(buf, order)
+			if err != nil {
+				return fmt.Errorf("unable to decode lon: %v", err)
+			}
+			lat, err := readWKBFloat64(buf, order)
+			if err != nil {
+				return fmt.Errorf("unable to decode lat: %v", err)
+			}
+			ring[j] = Point{Lon: lon, Lat: lat}
+		}
+		rings[i] = ring
+	}
+
+	*p = *NewPolygonWithHoles(rings)
+	return nil
+}
+
+func readWKBByteOrder(r *bytes.Reader) (binary.ByteOrder, error) {
+	flag, err := r.ReadByte()
+	if err != nil {
+		return nil, fmt.Errorf("unable to read WKB byte order flag: %v", err)
+	}
+	if flag == 0 {
+		return binary.BigEndian, nil
+	}
+	return binary.LittleEndian, nil
+}
+
+func readWKBUint32(r *bytes.Reader, order binary.ByteOrder) (uint32, error) {
+	var v uint32
+	err := binary.Read(r, order, &v)
+	return v, err
+}
+
+func readWKBFloat64(r *bytes.Reader, order binary.ByteOrder) (float64, error) {
+	var v float64
+	err := binary.Read(r, order, &v)
+	return v, err
+}
+
+// checkWKBCount rejects a count read off the wire (a ring count or a
+// per-ring point count) before it's used as a make() length. Each item the
+// count describes takes at least bytesPerItem more bytes of input, so a
+// count that would require more bytes than actually remain in the buffer
+// must be lying - without this check a single 9-byte payload can claim a
+// ring count of 0xffffffff and crash the process with an unrecoverable
+// out-of-memory error before a single byte of it is ever validated.
+func checkWKBCount(count uint32, bytesPerItem, remaining int) error {
+	if uint64(count) > uint64(remaining)/uint64(bytesPerItem) {
+		return fmt.Errorf("count %d would require more data than the %d bytes remaining", count, remaining)
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package geo
+
+import "testing"
+
+// Ensures that a Point round-trips through MarshalWKB/UnmarshalWKB.
+func TestPointWKBRoundTrip(t *testing.T) {
+	p := NewPoint(40.7486, -73.9864)
+
+	data, err := p.MarshalWKB()
+	if err != nil {
+		t.Fatal("Should not encounter an error when attempting to Marshal a Point to WKB", err)
+	}
+
+	actual := &Point{}
+	if err := actual.UnmarshalWKB(data); err != nil {
+		t.Fatal("Should not encounter an error when attempting to Unmarshal a Point from WKB", err)
+	}
+
+	if actual.Lat != p.Lat || actual.Lon != p.Lon {
+		t.Errorf("Point should correctly round-trip through WKB.\nExpected %+v\nBut got %+v", p, actual)
+	}
+}
+
+// Ensures that UnmarshalWKB rejects a geometry of the wrong type.
+func TestPointUnmarshalWKBWrongType(t *testing.T) {
+	poly := NewPolygon(square(10))
+	data, err := poly.MarshalWKB()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p := &Point{}
+	if err := p.UnmarshalWKB(data); err == nil {
+		t.Error("Expected an error when Unmarshalling a Polygon WKB payload into a Point")
+	}
+}
+
+// Ensures that a Polygon with a hole round-trips through MarshalWKB/UnmarshalWKB.
+func TestPolygonWKBRoundTrip(t *testing.T) {
+	poly := NewPolygonWithHoles([][]Point{square(10), square(4)})
+
+	data, err := poly.MarshalWKB()
+	if err != nil {
+		t.Fatal("Should not encounter an error when attempting to Marshal a Polygon to WKB", err)
+	}
+
+	actual := &Polygon{}
+	if err := actual.UnmarshalWKB(data); err != nil {
+		t.Fatal("Should not encounter an error when attempting to Unmarshal a Polygon from WKB", err)
+	}
+
+	if len(actual.Rings) != 2 {
+		t.Fatalf("Expected 2 rings after round-trip, but got %d", len(actual.Rings))
+	}
+
+	if actual.Rings[0][2].Lat != 10 || actual.Rings[1][2].Lat != 4 {
+		t.Errorf("Polygon has mismatched ring data after round-trip through WKB")
+	}
+}
+
+// Ensures that UnmarshalWKB rejects a ring count that claims far more data
+// than the payload actually contains, instead of attempting a huge
+// allocation.
+func TestPolygonUnmarshalWKBOversizedCount(t *testing.T) {
+	data := []byte{1, 3, 0, 0, 0, 0xff, 0xff, 0xff, 0xff}
+
+	p := &Polygon{}
+	if err := p.UnmarshalWKB(data); err == nil {
+		t.Error("Expected an error when Unmarshalling a WKB Polygon with an oversized ring count")
+	}
+}
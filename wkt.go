@@ -0,0 +1,126 @@
+package geo
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// MarshalWKT renders the current Point as Well-Known Text (WKT), e.g.
+// "POINT(-73.9864 40.7486)".
+func (p *Point) MarshalWKT() ([]byte, error) {
+	return []byte(fmt.Sprintf("POINT(%v %v)", p.Lon, p.Lat)), nil
+}
+
+// UnmarshalWKT decodes the current Point from a WKT POINT string.
+func (p *Point) UnmarshalWKT(data []byte) error {
+	text := strings.TrimSpace(string(data))
+	if !strings.HasPrefix(text, "POINT(") || !strings.HasSuffix(text, ")") {
+		return fmt.Errorf("expected a WKT POINT, got %q", text)
+	}
+
+	lon, lat, err := parseWKTCoordinate(text[len("POINT(") : len(text)-1])
+	if err != nil {
+		return fmt.Errorf("invalid WKT Point coordinates: %v", err)
+	}
+
+	p.Lon, p.Lat = lon, lat
+	return nil
+}
+
+// MarshalWKT renders the current Polygon as Well-Known Text (WKT), e.g.
+// "POLYGON((lon lat, lon lat, ...), (...))", one parenthesized group per
+// ring: the outer boundary first, then any interior holes.
+func (p *Polygon) MarshalWKT() ([]byte, error) {
+	rings := make([]string, len(p.Rings))
+	for i, ring := range p.Rings {
+		coords := make([]string, len(ring))
+		for j, pt := range ring {
+			coords[j] = fmt.Sprintf("%v %v", pt.Lon, pt.Lat)
+		}
+		rings[i] = "(" + strings.Join(coords, ", ") + ")"
+	}
+
+	return []byte(fmt.Sprintf("POLYGON(%s)", strings.Join(rings, ", "))), nil
+}
+
+// UnmarshalWKT decodes the current Polygon from a WKT POLYGON string,
+// including any interior holes.
+func (p *Polygon) UnmarshalWKT(data []byte) error {
+	text := strings.TrimSpace(string(data))
+	if !strings.HasPrefix(text, "POLYGON(") || !strings.HasSuffix(text, ")") {
+		return fmt.Errorf("expected a WKT POLYGON, got %q", text)
+	}
+
+	ringTexts, err := splitWKTRings(text[len("POLYGON(") : len(text)-1])
+	if err != nil {
+		return fmt.Errorf("invalid WKT Polygon rings: %v", err)
+	}
+
+	rings := make([][]Point, len(ringTexts))
+	for i, ringText := range ringTexts {
+		coords := strings.Split(ringText, ",")
+		ring := make([]Point, len(coords))
+		for j, c := range coords {
+			lon, lat, err := parseWKTCoordinate(c)
+			if err != nil {
+				return fmt.Errorf("invalid WKT Polygon coordinates: %v", err)
+			}
+			ring[j] = Point{Lon: lon, Lat: lat}
+		}
+		rings[i] = ring
+	}
+
+	*p = *NewPolygonWithHoles(rings)
+	return nil
+}
+
+// parseWKTCoordinate parses a single "lon lat" coordinate pair.
+func parseWKTCoordinate(s string) (lon, lat float64, err error) {
+	fields := strings.Fields(s)
+	if len(fields) != 2 {
+		return 0, 0, fmt.Errorf("expected 2 coordinates, got %d", len(fields))
+	}
+
+	lon, err = strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return 0, 0, err
+	}
+	lat, err = strconv.ParseFloat(fields[1], 64)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return lon, lat, nil
+}
+
+// splitWKTRings splits a comma-separated list of parenthesized rings, e.g.
+// "(a, b), (c, d)", into ["a, b", "c, d"], respecting the nested parens.
+func splitWKTRings(s string) ([]string, error) {
+	var rings []string
+	depth := 0
+	start := -1
+
+	for i, c := range s {
+		switch c {
+		case '(':
+			if depth == 0 {
+				start = i + 1
+			}
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				rings = append(rings, s[start:i])
+			} else if depth < 0 {
+				return nil, fmt.Errorf("unbalanced parentheses")
+			}
+		}
+	}
+
+	if depth != 0 {
+		return nil, fmt.Errorf("unbalanced parentheses")
+	}
+
+	return rings, nil
+}
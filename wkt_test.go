@@ -0,0 +1,61 @@
+package geo
+
+import "testing"
+
+// Ensures that a Point marshals to the expected WKT representation.
+func TestPointMarshalWKT(t *testing.T) {
+	p := NewPoint(40.7486, -73.9864)
+	res, err := p.MarshalWKT()
+
+	if err != nil {
+		t.Error("Should not encounter an error when attempting to Marshal a Point to WKT")
+	}
+
+	expected := "POINT(-73.9864 40.7486)"
+	if string(res) != expected {
+		t.Errorf("Point should correctly Marshal to WKT.\nExpected %s\nBut got %s", expected, res)
+	}
+}
+
+// Ensures that a Point can be unmarshalled from WKT.
+func TestPointUnmarshalWKT(t *testing.T) {
+	p := &Point{}
+	if err := p.UnmarshalWKT([]byte("POINT(-73.9864 40.7486)")); err != nil {
+		t.Fatal("Should not encounter an error when attempting to Unmarshal a Point from WKT", err)
+	}
+
+	if p.Lat != 40.7486 || p.Lon != -73.9864 {
+		t.Errorf("Point has mismatched data after Unmarshalling from WKT")
+	}
+}
+
+// Ensures that a Polygon with a hole round-trips through MarshalWKT/UnmarshalWKT.
+func TestPolygonWKTRoundTrip(t *testing.T) {
+	poly := NewPolygonWithHoles([][]Point{square(10), square(4)})
+
+	data, err := poly.MarshalWKT()
+	if err != nil {
+		t.Fatal("Should not encounter an error when attempting to Marshal a Polygon to WKT", err)
+	}
+
+	actual := &Polygon{}
+	if err := actual.UnmarshalWKT(data); err != nil {
+		t.Fatal("Should not encounter an error when attempting to Unmarshal a Polygon from WKT", err)
+	}
+
+	if len(actual.Rings) != 2 {
+		t.Fatalf("Expected 2 rings after round-trip, but got %d", len(actual.Rings))
+	}
+
+	if actual.Rings[0][2].Lat != 10 || actual.Rings[1][2].Lat != 4 {
+		t.Errorf("Polygon has mismatched ring data after round-trip through WKT")
+	}
+}
+
+// Ensures that UnmarshalWKT rejects malformed input.
+func TestPolygonUnmarshalWKTMalformed(t *testing.T) {
+	p := &Polygon{}
+	if err := p.UnmarshalWKT([]byte("POLYGON((0 0, 1 0)")); err == nil {
+		t.Error("Expected an error when Unmarshalling a malformed WKT Polygon")
+	}
+}